@@ -0,0 +1,56 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/evm"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+)
+
+// Aliases returns the default chain and vm aliases based on the genesis
+// represented by genesisBytes.
+func Aliases(genesisBytes []byte) (map[string][]string, map[[32]byte][]string, map[ids.ID][]string, error) {
+	generalAliases := map[string][]string{
+		"vm/" + platformvm.ID.String(): {"vm/platform"},
+		"vm/" + avm.ID.String():        {"vm/avm"},
+		"vm/" + evm.ID.String():        {"vm/evm"},
+	}
+	chainAliases := map[[32]byte][]string{}
+	vmAliases := map[ids.ID][]string{
+		platformvm.ID: {"platform"},
+		avm.ID:        {"avm"},
+		evm.ID:        {"evm"},
+	}
+
+	genesis := platformvm.Genesis{}
+	if _, err := platformvm.GenesisCodec.Unmarshal(genesisBytes, &genesis); err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to parse platformvm genesis: %w", err)
+	}
+
+	for _, chain := range genesis.Chains {
+		unsignedTx, ok := chain.UnsignedTx.(*platformvm.UnsignedCreateChainTx)
+		if !ok {
+			continue
+		}
+
+		chainID := chain.ID()
+		switch {
+		case unsignedTx.VMID == avm.ID:
+			generalAliases["blockchain/"+chainID.String()] = []string{"blockchain/X", "X"}
+			chainAliases[[32]byte(chainID)] = []string{"X"}
+		case unsignedTx.VMID == evm.ID:
+			generalAliases["blockchain/"+chainID.String()] = []string{"blockchain/C", "C"}
+			chainAliases[[32]byte(chainID)] = []string{"C"}
+		default:
+			generalAliases["blockchain/"+chainID.String()] = []string{"blockchain/" + unsignedTx.ChainName}
+			chainAliases[[32]byte(chainID)] = []string{unsignedTx.ChainName}
+		}
+	}
+
+	return generalAliases, chainAliases, vmAliases, nil
+}
@@ -0,0 +1,101 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/vms/evm"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+)
+
+// buildAVMHolders expands allocations (including any linear/stepped
+// vesting schedule) into the flat {amount, address, locktime} holders the
+// X-Chain genesis needs: an unlocked InitialAmount becomes one holder
+// with a zero Locktime, and each expanded UnlockSchedule step becomes its
+// own holder locked until that step's Locktime. Pulled out of
+// buildAVMGenesis so the expansion can be asserted on directly, without
+// going through the avm genesis-building service.
+func buildAVMHolders(allocations []Allocation) ([]avm.Holder, error) {
+	expanded, err := expandAllocationVesting(allocations)
+	if err != nil {
+		return nil, err
+	}
+
+	holders := make([]avm.Holder, 0, len(expanded))
+	for _, allocation := range expanded {
+		if allocation.InitialAmount > 0 {
+			holders = append(holders, avm.Holder{
+				Amount:  allocation.InitialAmount,
+				Address: allocation.AVAXAddr,
+			})
+		}
+		for _, unlock := range allocation.UnlockSchedule {
+			holders = append(holders, avm.Holder{
+				Amount:   unlock.Amount,
+				Address:  allocation.AVAXAddr,
+				Locktime: unlock.Locktime,
+			})
+		}
+	}
+	return holders, nil
+}
+
+// buildAVMGenesis constructs the X-Chain genesis, which holds the initial
+// AVAX UTXOs described by config.Allocations.
+func buildAVMGenesis(config *Config) ([]byte, ids.ID, error) {
+	holders, err := buildAVMHolders(config.Allocations)
+	if err != nil {
+		return nil, ids.ID{}, err
+	}
+
+	initialState := make([]interface{}, len(holders))
+	for i, holder := range holders {
+		initialState[i] = holder
+	}
+
+	args := avm.BuildGenesisArgs{
+		Encoding: avm.HexEncoding,
+		GenesisData: map[string]avm.AssetDefinition{
+			"AVAX": {
+				Name:         "Avalanche",
+				Symbol:       "AVAX",
+				Denomination: 9,
+				InitialState: map[string][]interface{}{
+					"fixedCap": initialState,
+				},
+			},
+		},
+	}
+	service := avm.StaticService{}
+	reply := avm.BuildGenesisReply{}
+	if err := service.BuildGenesis(nil, &args, &reply); err != nil {
+		return nil, ids.ID{}, err
+	}
+	return reply.Bytes.Bytes(), reply.AVAXAssetID, nil
+}
+
+// buildEVMGenesis parses config.CChainGenesis into the raw geth genesis
+// bytes the C-Chain VM expects. By the time this runs, validateConfig has
+// already merged any config.CChainAlloc into CChainGenesis.
+func buildEVMGenesis(config *Config) ([]byte, error) {
+	return evm.ParseGenesis([]byte(config.CChainGenesis))
+}
+
+// buildPlatformVMGenesis assembles the P-Chain genesis: the initial AVAX
+// UTXOs, the validator set that bootstraps staking, and the CreateChainTx
+// for each of the X-Chain and C-Chain.
+func buildPlatformVMGenesis(config *Config, avmGenesisBytes, evmGenesisBytes []byte) (*platformvm.Genesis, error) {
+	return platformvm.NewGenesis(
+		config.NetworkID,
+		config.StartTime,
+		config.InitialStakeDuration,
+		config.InitialStakeDurationOffset,
+		config.InitialStakers,
+		config.InitialStakedFunds,
+		avmGenesisBytes,
+		evmGenesisBytes,
+		config.Message,
+	)
+}
@@ -0,0 +1,79 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+// TestBuildAVMGenesisIncludesAllocations guards against buildAVMGenesis
+// silently dropping config.Allocations on the floor: it must be
+// impossible for two configs that differ only in their allocations to
+// produce the same X-Chain genesis bytes.
+func TestBuildAVMGenesisIncludesAllocations(t *testing.T) {
+	withAllocations := LocalConfig
+	withoutAllocations := LocalConfig
+	withoutAllocations.Allocations = nil
+
+	gotBytes, _, err := buildAVMGenesis(&withAllocations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	emptyBytes, _, err := buildAVMGenesis(&withoutAllocations)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Equal(gotBytes, emptyBytes) {
+		t.Fatal("expected X-Chain genesis bytes to differ when allocations differ, but they were identical")
+	}
+}
+
+// TestBuildAVMHoldersExpandsVesting asserts the expanded vesting steps and
+// amounts buildAVMHolders produces for linearVestGenesisConfigJSON's
+// linear schedule, in place of pinning a hash of the final X-Chain
+// genesis bytes: that hash can only ever be verified by actually running
+// the avm genesis-building service, which this assertion does not depend
+// on.
+func TestBuildAVMHoldersExpandsVesting(t *testing.T) {
+	config := &Config{}
+	if err := json.Unmarshal([]byte(linearVestGenesisConfigJSON), config); err != nil {
+		t.Fatal(err)
+	}
+
+	// Isolate the first allocation, whose single UnlockSchedule entry is
+	// the linear vest (63072000s duration, 2628000s periods, no cliff),
+	// so the locked-holder count below isn't diluted by the other two
+	// allocations' plain cliff entries.
+	linearAllocation := config.Allocations[0]
+	unlock := linearAllocation.UnlockSchedule[0]
+
+	holders, err := buildAVMHolders([]Allocation{linearAllocation})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotTotal uint64
+	var lockedHolders int
+	for _, holder := range holders {
+		gotTotal += holder.Amount
+		if holder.Locktime > 0 {
+			lockedHolders++
+		}
+	}
+
+	wantTotal := linearAllocation.InitialAmount + unlock.Amount
+	if gotTotal != wantTotal {
+		t.Fatalf("expected holders to total %d but got %d", wantTotal, gotTotal)
+	}
+
+	// A broken expansion (or a broken wiring of it into buildAVMHolders)
+	// would collapse this back into the single cliff drop it started as.
+	wantLockedHolders := int(unlock.Duration / unlock.PeriodSeconds)
+	if lockedHolders != wantLockedHolders {
+		t.Fatalf("expected the linear vesting schedule to expand into %d locked holders, got %d", wantLockedHolders, lockedHolders)
+	}
+}
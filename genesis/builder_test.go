@@ -0,0 +1,52 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/utils/hashing"
+)
+
+// TestBuilderMatchesLocalConfig checks that assembling LocalConfig through
+// the Builder, field by field, produces the exact same genesis bytes as
+// Genesis() does for the registered LocalConfig.
+func TestBuilderMatchesLocalConfig(t *testing.T) {
+	builder := NewBuilder(LocalConfig.NetworkID).
+		WithStartTime(LocalConfig.StartTime).
+		WithInitialStake(LocalConfig.InitialStakeDuration, LocalConfig.InitialStakeDurationOffset, LocalConfig.InitialStakedFunds).
+		WithCChainGenesis(LocalConfig.CChainGenesis).
+		WithMessage(LocalConfig.Message)
+
+	for _, allocation := range LocalConfig.Allocations {
+		builder = builder.AddAllocation(allocation)
+	}
+	for _, staker := range LocalConfig.InitialStakers {
+		builder = builder.AddStaker(staker)
+	}
+
+	built, _, err := builder.BuildBytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, _, err := Genesis(LocalConfig.NetworkID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotHash := fmt.Sprintf("%x", hashing.ComputeHash256(built))
+	expectedHash := fmt.Sprintf("%x", hashing.ComputeHash256(expected))
+	if gotHash != expectedHash {
+		t.Fatalf("builder-assembled genesis hash %s did not match Genesis() hash %s", gotHash, expectedHash)
+	}
+}
+
+func TestBuilderValidates(t *testing.T) {
+	_, err := NewBuilder(12345).Build()
+	if err == nil {
+		t.Fatal("expected Build to reject a Config with no allocations or stakers")
+	}
+}
@@ -0,0 +1,62 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+// mergeCChainAlloc decodes rawGenesis as a geth-style genesis document,
+// replaces its "alloc" section with alloc, and re-encodes it. It is the
+// shared path between Builder.WithCChainAlloc, which merges an alloc map
+// supplied in-process, and loading one from a genesis.json file on disk.
+func mergeCChainAlloc(rawGenesis string, alloc map[common.Address]core.GenesisAccount) (string, error) {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(rawGenesis), &doc); err != nil {
+		return "", fmt.Errorf("unable to parse C-Chain genesis: %w", err)
+	}
+
+	allocBytes, err := json.Marshal(alloc)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal C-Chain allocation: %w", err)
+	}
+	doc["alloc"] = allocBytes
+
+	merged, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal C-Chain genesis: %w", err)
+	}
+	return string(merged), nil
+}
+
+// cChainGenesisHasAlloc reports whether rawGenesis already declares a
+// non-empty "alloc" section, so callers merging in an allocation from
+// elsewhere can detect a conflict instead of silently overwriting it.
+func cChainGenesisHasAlloc(rawGenesis string) (bool, error) {
+	var doc struct {
+		Alloc map[string]json.RawMessage `json:"alloc"`
+	}
+	if err := json.Unmarshal([]byte(rawGenesis), &doc); err != nil {
+		return false, fmt.Errorf("unable to parse C-Chain genesis: %w", err)
+	}
+	return len(doc.Alloc) > 0, nil
+}
+
+// WithCChainAlloc replaces the C-Chain genesis's "alloc" section with
+// alloc; entries already in that section are dropped. It must be called
+// after WithCChainGenesis, since it starts from the genesis document
+// already supplied.
+func (b *Builder) WithCChainAlloc(alloc map[common.Address]core.GenesisAccount) *Builder {
+	merged, err := mergeCChainAlloc(b.config.CChainGenesis, alloc)
+	if err != nil {
+		b.err = err
+		return b
+	}
+	b.config.CChainGenesis = merged
+	return b
+}
@@ -0,0 +1,117 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+// CChainAccount is a single entry of a C-Chain allocation file, mirroring
+// the shape of a geth core.Genesis.Alloc entry so that the output of
+// `geth dump` or `geth export` can be pointed at directly.
+type CChainAccount struct {
+	Balance string                      `json:"balance"`
+	Code    string                      `json:"code,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+func (a CChainAccount) toGenesisAccount() (core.GenesisAccount, error) {
+	balance, ok := new(big.Int).SetString(a.Balance, 0)
+	if !ok {
+		return core.GenesisAccount{}, fmt.Errorf("invalid balance %q", a.Balance)
+	}
+
+	var code []byte
+	if len(a.Code) > 0 {
+		code = common.FromHex(a.Code)
+	}
+
+	return core.GenesisAccount{
+		Balance: balance,
+		Code:    code,
+		Nonce:   a.Nonce,
+		Storage: a.Storage,
+	}, nil
+}
+
+// mergeConfigCChainAlloc merges a Config.CChainAlloc map into rawGenesis.
+func mergeConfigCChainAlloc(rawGenesis string, configAlloc map[common.Address]CChainAccount) (string, error) {
+	alloc := make(map[common.Address]core.GenesisAccount, len(configAlloc))
+	for addr, account := range configAlloc {
+		genesisAccount, err := account.toGenesisAccount()
+		if err != nil {
+			return "", fmt.Errorf("invalid CChainAlloc entry for %s: %w", addr, err)
+		}
+		alloc[addr] = genesisAccount
+	}
+	return mergeCChainAlloc(rawGenesis, alloc)
+}
+
+// emptyCChainGenesisTemplate is a geth-style genesis document with no
+// allocation, used by LoadCChainGenesisFromFile as the base document that
+// an allocation file is merged into.
+const emptyCChainGenesisTemplate = `{
+	"config": {
+		"chainId": 0,
+		"homesteadBlock": 0,
+		"daoForkBlock": 0,
+		"daoForkSupport": true,
+		"eip150Block": 0,
+		"eip155Block": 0,
+		"eip158Block": 0,
+		"byzantiumBlock": 0,
+		"constantinopleBlock": 0,
+		"petersburgBlock": 0,
+		"istanbulBlock": 0,
+		"muirGlacierBlock": 0
+	},
+	"nonce": "0x0",
+	"timestamp": "0x0",
+	"extraData": "0x00",
+	"gasLimit": "0x5f5e100",
+	"difficulty": "0x0",
+	"mixHash": "0x0000000000000000000000000000000000000000000000000000000000000000",
+	"coinbase": "0x0000000000000000000000000000000000000000",
+	"alloc": {},
+	"number": "0x0",
+	"gasUsed": "0x0",
+	"parentHash": "0x0000000000000000000000000000000000000000000000000000000000000000"
+}`
+
+// LoadCChainGenesisFromFile reads a C-Chain allocation file at path - the
+// {address: {balance, code, nonce, storage}} document produced by `geth
+// dump` or `geth export` - and returns the resulting cChainGenesis
+// string, with the allocation merged into emptyCChainGenesisTemplate.
+// The returned string is meant to be assigned directly to
+// Config.CChainGenesis to build a C-Chain genesis from scratch. This
+// package defines no command-line flag that calls it.
+//
+// It always starts from emptyCChainGenesisTemplate, not from whatever
+// network the resulting Config will otherwise describe, so it discards
+// that network's chain config (fork blocks, chain ID, gas limit, ...)
+// rather than layering allocations onto it. Do not use it to add state
+// to an existing network's CChainGenesis (e.g. mainnet, fuji, or a
+// custom net with its own C-Chain config) - use Config.CChainAlloc for
+// that instead, since validateConfig merges it into whatever
+// CChainGenesis is already set.
+func LoadCChainGenesisFromFile(path string) (string, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read C-Chain allocation file: %w", err)
+	}
+
+	var accounts map[common.Address]CChainAccount
+	if err := json.Unmarshal(bytes, &accounts); err != nil {
+		return "", fmt.Errorf("unable to parse C-Chain allocation file: %w", err)
+	}
+
+	return mergeConfigCChainAlloc(emptyCChainGenesisTemplate, accounts)
+}
@@ -0,0 +1,104 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"path"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/vms/evm"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+var sampleAllocFile = `{
+	"0x71413529ede9df2abf8de12a1b4f2b5ad09db9c4": {
+		"balance": "0x56bc75e2d63100000",
+		"nonce": 1
+	}
+}`
+
+func TestLoadCChainGenesisFromFile(t *testing.T) {
+	allocPath := path.Join(t.TempDir(), "alloc.json")
+	if err := ioutil.WriteFile(allocPath, []byte(sampleAllocFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := LoadCChainGenesisFromFile(allocPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var doc struct {
+		Alloc map[common.Address]json.RawMessage `json:"alloc"`
+	}
+	if err := json.Unmarshal([]byte(raw), &doc); err != nil {
+		t.Fatal(err)
+	}
+	addr := common.HexToAddress("0x71413529ede9df2abf8de12a1b4f2b5ad09db9c4")
+	if _, ok := doc.Alloc[addr]; !ok {
+		t.Fatalf("expected merged genesis to contain allocation for %s", addr)
+	}
+}
+
+// cChainGenesisWithNonEmptyAlloc is a minimal geth-style genesis document
+// whose "alloc" section is already populated, used to exercise the
+// conflict check in validateConfig.
+const cChainGenesisWithNonEmptyAlloc = `{"config":{"chainId":1},"alloc":{"0x71413529ede9df2abf8de12a1b4f2b5ad09db9c4":{"balance":"0x1"}}}`
+
+func TestValidateConfigCChainAllocConflict(t *testing.T) {
+	thisConfig := LocalConfig
+	thisConfig.CChainGenesis = cChainGenesisWithNonEmptyAlloc
+	thisConfig.CChainAlloc = map[common.Address]CChainAccount{
+		common.HexToAddress("0x71413529ede9df2abf8de12a1b4f2b5ad09db9c4"): {Balance: "0x1"},
+	}
+
+	err := validateConfig(thisConfig.NetworkID, &thisConfig)
+	if err == nil {
+		t.Fatal("expected an error merging CChainAlloc into a CChainGenesis that already has an allocation")
+	}
+}
+
+func TestValidateConfigCChainAllocMerges(t *testing.T) {
+	thisConfig := LocalConfig
+	thisConfig.CChainGenesis = emptyCChainGenesisTemplate
+	thisConfig.CChainAlloc = map[common.Address]CChainAccount{
+		common.HexToAddress("0x71413529ede9df2abf8de12a1b4f2b5ad09db9c4"): {Balance: "0x1"},
+	}
+
+	if err := validateConfig(thisConfig.NetworkID, &thisConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	genesisBytes, _, err := FromConfig(&thisConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cChainTx, err := VMGenesis(genesisBytes, evm.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsignedTx, ok := cChainTx.UnsignedTx.(*platformvm.UnsignedCreateChainTx)
+	if !ok {
+		t.Fatalf("expected a CreateChainTx for the C-Chain, got %T", cChainTx.UnsignedTx)
+	}
+
+	var cChainGenesis core.Genesis
+	if err := json.Unmarshal(unsignedTx.GenesisData, &cChainGenesis); err != nil {
+		t.Fatal(err)
+	}
+	addr := common.HexToAddress("0x71413529ede9df2abf8de12a1b4f2b5ad09db9c4")
+	account, ok := cChainGenesis.Alloc[addr]
+	if !ok {
+		t.Fatalf("expected merged C-Chain genesis to contain allocation for %s", addr)
+	}
+	if account.Balance.Cmp(big.NewInt(1)) != 0 {
+		t.Fatalf("expected merged allocation for %s to have balance 1 but got %s", addr, account.Balance)
+	}
+}
@@ -0,0 +1,75 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// LockedAmount is one entry in an Allocation's vesting schedule. By
+// default (VestingType unset, or "cliff") it is a single cliff-style
+// unlock: Amount becomes spendable all at once once the network reaches
+// Locktime.
+//
+// Setting VestingType to "linear" or "stepped" instead spreads Amount out
+// over Duration seconds starting at Locktime, releasing it in
+// PeriodSeconds-sized increments; Cliff delays the first release. See
+// expandVestingSchedule for the exact expansion into plain cliff drops.
+// "stepped" additionally requires PeriodSeconds to evenly divide
+// Duration; "linear" allows a final, shorter step to absorb the
+// remainder.
+type LockedAmount struct {
+	Amount   uint64 `json:"amount"`
+	Locktime uint64 `json:"locktime"`
+
+	VestingType   string `json:"vestingType,omitempty"`
+	Cliff         uint64 `json:"cliff,omitempty"`
+	Duration      uint64 `json:"duration,omitempty"`
+	PeriodSeconds uint64 `json:"periodSeconds,omitempty"`
+}
+
+// Allocation describes the initial AVAX balance assigned to a single
+// address at genesis, along with any portion of that balance that is
+// locked behind an UnlockSchedule.
+type Allocation struct {
+	ETHAddr        ids.ShortID    `json:"ethAddr"`
+	AVAXAddr       ids.ShortID    `json:"avaxAddr"`
+	InitialAmount  uint64         `json:"initialAmount"`
+	UnlockSchedule []LockedAmount `json:"unlockSchedule"`
+}
+
+// Staker describes one of the validators that is staking at genesis.
+type Staker struct {
+	NodeID        ids.ShortID `json:"nodeID"`
+	RewardAddress ids.ShortID `json:"rewardAddress"`
+	DelegationFee uint32      `json:"delegationFee"`
+}
+
+// Config defines the genesis state of the network: the initial AVAX
+// allocations, the validators that bootstrap the network, and the
+// genesis state handed to the X-Chain, C-Chain and P-Chain.
+type Config struct {
+	NetworkID uint32 `json:"networkID"`
+
+	Allocations []Allocation `json:"allocations"`
+
+	StartTime                  uint64        `json:"startTime"`
+	InitialStakeDuration       uint64        `json:"initialStakeDuration"`
+	InitialStakeDurationOffset uint64        `json:"initialStakeDurationOffset"`
+	InitialStakedFunds         []ids.ShortID `json:"initialStakedFunds"`
+	InitialStakers             []Staker      `json:"initialStakers"`
+
+	CChainGenesis string `json:"cChainGenesis"`
+
+	// CChainAlloc, if non-empty, is merged into CChainGenesis by
+	// validateConfig. It lets an operator point a custom config at a
+	// C-Chain allocation dump (e.g. from `geth dump`) instead of having
+	// to hand-embed it into the CChainGenesis string. Setting both a
+	// non-empty CChainAlloc and an inline CChainGenesis "alloc" section
+	// is a validation error.
+	CChainAlloc map[common.Address]CChainAccount `json:"cChainAlloc,omitempty"`
+
+	Message string `json:"message"`
+}
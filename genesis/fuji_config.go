@@ -0,0 +1,73 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"encoding/json"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+var fujiGenesisConfigJSON = `{
+	"networkID": 5,
+	"allocations": [
+		{
+			"ethAddr": "0x4cb3fc489c0c77be2c79fd6f58cc8c8a0dda36d1",
+			"avaxAddr": "X-fuji1g65uqn6t77p656w64023nh8nd9updzmxdt5qv",
+			"initialAmount": 0,
+			"unlockSchedule": [
+				{
+					"amount": 10000000000000000,
+					"locktime": 1607626800
+				}
+			]
+		},
+		{
+			"ethAddr": "0x4cb3fc489c0c77be2c79fd6f58cc8c8a0dda36d1",
+			"avaxAddr": "X-fuji18jma8ppw3nhx5r4ap8clazz0dps7rv5uj3gr5v",
+			"initialAmount": 300000000000000000,
+			"unlockSchedule": [
+				{
+					"amount": 20000000000000000
+				},
+				{
+					"amount": 10000000000000000,
+					"locktime": 1607626800
+				}
+			]
+		}
+	],
+	"startTime": 1599696000,
+	"initialStakeDuration": 31536000,
+	"initialStakeDurationOffset": 5400,
+	"initialStakedFunds": [
+		"X-fuji1g65uqn6t77p656w64023nh8nd9updzmxdt5qv"
+	],
+	"initialStakers": [
+		{
+			"nodeID": "NodeID-NpagUxt6KQiwPch9Sd4osv8kD1TZnkjdk",
+			"rewardAddress": "X-fuji18jma8ppw3nhx5r4ap8clazz0dps7rv5uj3gr5v",
+			"delegationFee": 1000000
+		},
+		{
+			"nodeID": "NodeID-2m38qc95mhHXtrhjyGbe7r2NhniEDvAwx",
+			"rewardAddress": "X-fuji18jma8ppw3nhx5r4ap8clazz0dps7rv5uj3gr5v",
+			"delegationFee": 500000
+		}
+	],
+	"cChainGenesis": "{\"config\":{\"chainId\":43113,\"homesteadBlock\":0,\"daoForkBlock\":0,\"daoForkSupport\":true,\"eip150Block\":0,\"eip150Hash\":\"0x2086799aeebeae135c246c65021c82b4e15a2c451340993aacfd2751886514f0\",\"eip155Block\":0,\"eip158Block\":0,\"byzantiumBlock\":0,\"constantinopleBlock\":0,\"petersburgBlock\":0,\"istanbulBlock\":0,\"muirGlacierBlock\":0},\"nonce\":\"0x0\",\"timestamp\":\"0x0\",\"extraData\":\"0x00\",\"gasLimit\":\"0x5f5e100\",\"difficulty\":\"0x0\",\"mixHash\":\"0x0000000000000000000000000000000000000000000000000000000000000000\",\"coinbase\":\"0x0000000000000000000000000000000000000000\",\"alloc\":{},\"number\":\"0x0\",\"gasUsed\":\"0x0\",\"parentHash\":\"0x0000000000000000000000000000000000000000000000000000000000000000\"}",
+	"message": "A Gift To Remember, Engraved Forever: Fuji"
+}`
+
+// FujiConfig is the genesis config for the Fuji test network (networkID
+// 5), the long-lived public testnet operators use to rehearse mainnet
+// upgrades.
+var FujiConfig Config
+
+func init() {
+	if err := json.Unmarshal([]byte(fujiGenesisConfigJSON), &FujiConfig); err != nil {
+		panic(err)
+	}
+	RegisterNetwork(constants.FujiName, constants.FujiID, &FujiConfig)
+}
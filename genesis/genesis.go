@@ -0,0 +1,190 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+)
+
+// Genesis returns the genesis bytes and AVAX asset ID for the network
+// identified by networkID. If filepath is non-empty and networkID is
+// neither mainnet nor fuji, it is parsed as a custom genesis config and
+// used in place of any config registered for networkID. For mainnet and
+// fuji, filepath is ignored and the registered config is always used.
+func Genesis(networkID uint32, filepath string) ([]byte, ids.ID, error) {
+	config := configForNetworkID(networkID)
+
+	if len(filepath) > 0 && networkID != constants.MainnetID && networkID != constants.FujiID {
+		customConfig, err := GetConfigFile(filepath)
+		if err != nil {
+			return nil, ids.ID{}, fmt.Errorf("unable to load provided genesis config at %s: %w", filepath, err)
+		}
+		config = customConfig
+	}
+
+	if config == nil {
+		return nil, ids.ID{}, fmt.Errorf("no genesis config registered for network ID %d", networkID)
+	}
+
+	if err := validateConfig(networkID, config); err != nil {
+		return nil, ids.ID{}, err
+	}
+
+	return FromConfig(config)
+}
+
+// GetConfigFile reads and unmarshals the genesis config at filepath. If a
+// companion filepath+".sig" exists, it is verified against TrustedKeys
+// before the config is parsed; see verifyGenesisSignature.
+func GetConfigFile(filepath string) (*Config, error) {
+	bytes, err := ioutil.ReadFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyGenesisSignature(filepath, bytes); err != nil {
+		return nil, err
+	}
+
+	config := &Config{}
+	if err := json.Unmarshal(bytes, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// validateConfig performs structural sanity checks on config before it is
+// used to build genesis state. networkID is the ID the caller expects
+// config to describe; it may differ from config.NetworkID when a custom
+// config file was loaded for the wrong network.
+func validateConfig(networkID uint32, config *Config) error {
+	if networkID != config.NetworkID {
+		return fmt.Errorf(
+			"networkID %d specified but genesis config contains networkID %d",
+			networkID,
+			config.NetworkID,
+		)
+	}
+
+	if uint64(time.Now().Unix()) < config.StartTime {
+		return fmt.Errorf("start time cannot be in the future")
+	}
+
+	stakedFundsSet := ids.ShortSet{}
+	stakedFundsSet.Add(config.InitialStakedFunds...)
+	if stakedFundsSet.Len() != len(config.InitialStakedFunds) {
+		return fmt.Errorf("duplicated in initial staked funds")
+	}
+	if stakedFundsSet.Len() == 0 {
+		return fmt.Errorf("initial staked funds cannot be empty")
+	}
+
+	allocationSet := ids.ShortSet{}
+	var initialSupply uint64
+	for _, allocation := range config.Allocations {
+		allocationSet.Add(allocation.AVAXAddr)
+		initialSupply += allocation.InitialAmount
+		for _, unlock := range allocation.UnlockSchedule {
+			initialSupply += unlock.Amount
+
+			expanded, err := expandVestingSchedule(unlock)
+			if err != nil {
+				return fmt.Errorf("allocation %s has an invalid vesting schedule: %w", allocation.AVAXAddr, err)
+			}
+			var expandedTotal uint64
+			for _, step := range expanded {
+				expandedTotal += step.Amount
+			}
+			if expandedTotal != unlock.Amount {
+				return fmt.Errorf(
+					"allocation %s vesting schedule expands to %d but declared amount is %d",
+					allocation.AVAXAddr,
+					expandedTotal,
+					unlock.Amount,
+				)
+			}
+		}
+	}
+	if initialSupply == 0 {
+		return fmt.Errorf("initial supply must be > 0")
+	}
+	for _, addr := range config.InitialStakedFunds {
+		if !allocationSet.Contains(addr) {
+			return fmt.Errorf("address %s does not have an allocation to stake", addr)
+		}
+	}
+
+	if len(config.InitialStakers) == 0 {
+		return fmt.Errorf("initial stakers must be > 0")
+	}
+
+	if config.InitialStakeDuration == 0 {
+		return fmt.Errorf("initial stake duration must be > 0")
+	}
+	if minDuration := config.InitialStakeDurationOffset * uint64(len(config.InitialStakers)-1); config.InitialStakeDuration <= minDuration {
+		return fmt.Errorf(
+			"initial stake duration is %d but need at least %d with offset of %d",
+			config.InitialStakeDuration,
+			minDuration,
+			config.InitialStakeDurationOffset,
+		)
+	}
+
+	if len(config.CChainAlloc) > 0 {
+		hasInlineAlloc, err := cChainGenesisHasAlloc(config.CChainGenesis)
+		if err != nil {
+			return err
+		}
+		if hasInlineAlloc {
+			return fmt.Errorf("CChainGenesis already specifies an allocation; cannot also merge CChainAlloc")
+		}
+
+		merged, err := mergeConfigCChainAlloc(config.CChainGenesis, config.CChainAlloc)
+		if err != nil {
+			return err
+		}
+		config.CChainGenesis = merged
+	}
+
+	if len(config.CChainGenesis) == 0 {
+		return fmt.Errorf("C-Chain genesis cannot be empty")
+	}
+
+	if len(config.Message) == 0 {
+		return fmt.Errorf("genesis message cannot be empty")
+	}
+
+	return nil
+}
+
+// VMGenesis extracts the genesis transaction that created vmID's chain
+// from the already-built platformvm genesis bytes.
+func VMGenesis(genesisBytes []byte, vmID ids.ID) (*platformvm.Tx, error) {
+	genesis := platformvm.Genesis{}
+	if _, err := platformvm.GenesisCodec.Unmarshal(genesisBytes, &genesis); err != nil {
+		return nil, fmt.Errorf("unable to parse platformvm genesis: %w", err)
+	}
+
+	for _, chain := range genesis.Chains {
+		unsignedTx, ok := chain.UnsignedTx.(*platformvm.UnsignedCreateChainTx)
+		if ok && unsignedTx.VMID == vmID {
+			return chain, nil
+		}
+	}
+	return nil, fmt.Errorf("couldn't find blockchain with VM ID %s", vmID)
+}
+
+// AVAXAssetID returns the asset ID that the X-Chain genesis assigns to
+// AVAX for the given network.
+func AVAXAssetID(networkID uint32) (ids.ID, error) {
+	_, avaxAssetID, err := Genesis(networkID, "")
+	return avaxAssetID, err
+}
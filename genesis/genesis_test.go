@@ -250,6 +250,85 @@ var (
 	invalidGenesisConfigJSON = `{
 		"networkID": 9999}}}}
 	}`
+	linearVestGenesisConfigJSON = `{
+		"networkID": 9999,
+		"allocations": [
+			{
+				"ethAddr": "0xb3d82b1367d362de99ab59a658165aff520cbd4d",
+				"avaxAddr": "X-local1g65uqn6t77p656w64023nh8nd9updzmxyymev2",
+				"initialAmount": 0,
+				"unlockSchedule": [
+					{
+						"amount": 10000000000000000,
+						"locktime": 1633824000,
+						"vestingType": "linear",
+						"duration": 63072000,
+						"periodSeconds": 2628000
+					}
+				]
+			},
+			{
+				"ethAddr": "0xb3d82b1367d362de99ab59a658165aff520cbd4d",
+				"avaxAddr": "X-local18jma8ppw3nhx5r4ap8clazz0dps7rv5u00z96u",
+				"initialAmount": 300000000000000000,
+				"unlockSchedule": [
+					{
+						"amount": 20000000000000000
+					},
+					{
+						"amount": 10000000000000000,
+						"locktime": 1633824000
+					}
+				]
+			},
+			{
+				"ethAddr": "0xb3d82b1367d362de99ab59a658165aff520cbd4d",
+				"avaxAddr": "X-local1ur873jhz9qnaqv5qthk5sn3e8nj3e0kmggalnu",
+				"initialAmount": 10000000000000000,
+				"unlockSchedule": [
+					{
+						"amount": 10000000000000000,
+						"locktime": 1633824000
+					}
+				]
+			}
+		],
+		"startTime": 1599696000,
+		"initialStakeDuration": 31536000,
+		"initialStakeDurationOffset": 5400,
+		"initialStakedFunds": [
+			"X-local1g65uqn6t77p656w64023nh8nd9updzmxyymev2"
+		],
+		"initialStakers": [
+			{
+				"nodeID": "NodeID-7Xhw2mDxuDS44j42TCB6U5579esbSt3Lg",
+				"rewardAddress": "X-local18jma8ppw3nhx5r4ap8clazz0dps7rv5u00z96u",
+				"delegationFee": 1000000
+			},
+			{
+				"nodeID": "NodeID-MFrZFVCXPv5iCn6M9K6XduxGTYp891xXZ",
+				"rewardAddress": "X-local18jma8ppw3nhx5r4ap8clazz0dps7rv5u00z96u",
+				"delegationFee": 500000
+			},
+			{
+				"nodeID": "NodeID-NFBbbJ4qCmNaCzeW7sxErhvWqvEQMnYcN",
+				"rewardAddress": "X-local18jma8ppw3nhx5r4ap8clazz0dps7rv5u00z96u",
+				"delegationFee": 250000
+			},
+			{
+				"nodeID": "NodeID-GWPcbFJZFfZreETSoWjPimr846mXEKCtu",
+				"rewardAddress": "X-local18jma8ppw3nhx5r4ap8clazz0dps7rv5u00z96u",
+				"delegationFee": 125000
+			},
+			{
+				"nodeID": "NodeID-P7oB2McjBGgW2NXXWVYjV8JEDFoW9xDE5",
+				"rewardAddress": "X-local18jma8ppw3nhx5r4ap8clazz0dps7rv5u00z96u",
+				"delegationFee": 62500
+			}
+		],
+		"cChainGenesis": "{\"config\":{\"chainId\":43112,\"homesteadBlock\":0,\"daoForkBlock\":0,\"daoForkSupport\":true,\"eip150Block\":0,\"eip150Hash\":\"0x2086799aeebeae135c246c65021c82b4e15a2c451340993aacfd2751886514f0\",\"eip155Block\":0,\"eip158Block\":0,\"byzantiumBlock\":0,\"constantinopleBlock\":0,\"petersburgBlock\":0,\"istanbulBlock\":0,\"muirGlacierBlock\":0},\"nonce\":\"0x0\",\"timestamp\":\"0x0\",\"extraData\":\"0x00\",\"gasLimit\":\"0x5f5e100\",\"difficulty\":\"0x0\",\"mixHash\":\"0x0000000000000000000000000000000000000000000000000000000000000000\",\"coinbase\":\"0x0000000000000000000000000000000000000000\",\"alloc\":{},\"number\":\"0x0\",\"gasUsed\":\"0x0\",\"parentHash\":\"0x0000000000000000000000000000000000000000000000000000000000000000\"}",
+		"message": "{{ fun_quote }}"
+	}`
 )
 
 func TestGenesis(t *testing.T) {
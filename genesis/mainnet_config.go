@@ -0,0 +1,72 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"encoding/json"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+)
+
+var mainnetGenesisConfigJSON = `{
+	"networkID": 1,
+	"allocations": [
+		{
+			"ethAddr": "0x71413529ede9df2abf8de12a1b4f2b5ad09db9c4",
+			"avaxAddr": "X-avax1g65uqn6t77p656w64023nh8nd9updzmxh8ttv2",
+			"initialAmount": 0,
+			"unlockSchedule": [
+				{
+					"amount": 10000000000000000,
+					"locktime": 1608249600
+				}
+			]
+		},
+		{
+			"ethAddr": "0x71413529ede9df2abf8de12a1b4f2b5ad09db9c4",
+			"avaxAddr": "X-avax18jma8ppw3nhx5r4ap8clazz0dps7rv5utmmdrt",
+			"initialAmount": 300000000000000000,
+			"unlockSchedule": [
+				{
+					"amount": 20000000000000000
+				},
+				{
+					"amount": 10000000000000000,
+					"locktime": 1608249600
+				}
+			]
+		}
+	],
+	"startTime": 1599696000,
+	"initialStakeDuration": 31536000,
+	"initialStakeDurationOffset": 5400,
+	"initialStakedFunds": [
+		"X-avax1g65uqn6t77p656w64023nh8nd9updzmxh8ttv2"
+	],
+	"initialStakers": [
+		{
+			"nodeID": "NodeID-7Xhw2mDxuDS44j42TCB6U5579esbSt3Lg",
+			"rewardAddress": "X-avax18jma8ppw3nhx5r4ap8clazz0dps7rv5utmmdrt",
+			"delegationFee": 1000000
+		},
+		{
+			"nodeID": "NodeID-MFrZFVCXPv5iCn6M9K6XduxGTYp891xXZ",
+			"rewardAddress": "X-avax18jma8ppw3nhx5r4ap8clazz0dps7rv5utmmdrt",
+			"delegationFee": 500000
+		}
+	],
+	"cChainGenesis": "{\"config\":{\"chainId\":43114,\"homesteadBlock\":0,\"daoForkBlock\":0,\"daoForkSupport\":true,\"eip150Block\":0,\"eip150Hash\":\"0x2086799aeebeae135c246c65021c82b4e15a2c451340993aacfd2751886514f0\",\"eip155Block\":0,\"eip158Block\":0,\"byzantiumBlock\":0,\"constantinopleBlock\":0,\"petersburgBlock\":0,\"istanbulBlock\":0,\"muirGlacierBlock\":0},\"nonce\":\"0x0\",\"timestamp\":\"0x0\",\"extraData\":\"0x00\",\"gasLimit\":\"0x5f5e100\",\"difficulty\":\"0x0\",\"mixHash\":\"0x0000000000000000000000000000000000000000000000000000000000000000\",\"coinbase\":\"0x0000000000000000000000000000000000000000\",\"alloc\":{},\"number\":\"0x0\",\"gasUsed\":\"0x0\",\"parentHash\":\"0x0000000000000000000000000000000000000000000000000000000000000000\"}",
+	"message": "AVAX mainnet genesis"
+}`
+
+// MainnetConfig is the genesis config for the Avalanche primary network
+// (networkID 1).
+var MainnetConfig Config
+
+func init() {
+	if err := json.Unmarshal([]byte(mainnetGenesisConfigJSON), &MainnetConfig); err != nil {
+		panic(err)
+	}
+	RegisterNetwork(constants.MainnetName, constants.MainnetID, &MainnetConfig)
+}
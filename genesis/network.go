@@ -0,0 +1,96 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// networkProfile pairs a registered genesis Config with the numeric
+// network ID it was registered under.
+type networkProfile struct {
+	id     uint32
+	config *Config
+}
+
+var (
+	networkRegistryMu sync.RWMutex
+	networksByName    = map[string]networkProfile{}
+	networksByID      = map[uint32]networkProfile{}
+)
+
+// RegisterNetwork links a name and a numeric network ID to a genesis
+// Config. It is intended to be called from an init() function, either in
+// this package for the built-in networks (mainnet, fuji, local) or from a
+// downstream package that wants to link in its own persistent network
+// (e.g. a long-lived staging net) without forking this package.
+//
+// RegisterNetwork panics if id or name collide with a previously
+// registered network, since that would silently make genesis resolution
+// order-dependent on init order.
+func RegisterNetwork(name string, id uint32, cfg *Config) {
+	networkRegistryMu.Lock()
+	defer networkRegistryMu.Unlock()
+
+	if existing, ok := networksByName[name]; ok {
+		panic(fmt.Errorf("genesis: network name %q already registered with ID %d", name, existing.id))
+	}
+	if existing, ok := networksByID[id]; ok {
+		panic(fmt.Errorf("genesis: network ID %d already registered with name %q", id, existingName(existing)))
+	}
+
+	profile := networkProfile{id: id, config: cfg}
+	networksByName[name] = profile
+	networksByID[id] = profile
+}
+
+func existingName(p networkProfile) string {
+	for name, profile := range networksByName {
+		if profile.id == p.id {
+			return name
+		}
+	}
+	return ""
+}
+
+// LookupNetwork resolves nameOrID, which may either be the registered name
+// of a network (e.g. "mainnet", "fuji", "denali") or its numeric ID given
+// as a base-10 string (e.g. "5"), to its registered Config and network ID.
+//
+// LookupNetwork is what a --network-id flag that accepts names as well as
+// integers would resolve through; wiring that flag up on the node CLI is
+// out of scope for this package and is not done here, so today callers
+// must invoke LookupNetwork directly.
+func LookupNetwork(nameOrID string) (uint32, *Config, error) {
+	networkRegistryMu.RLock()
+	defer networkRegistryMu.RUnlock()
+
+	if profile, ok := networksByName[nameOrID]; ok {
+		return profile.id, profile.config, nil
+	}
+
+	if id, err := strconv.ParseUint(nameOrID, 10, 32); err == nil {
+		if profile, ok := networksByID[uint32(id)]; ok {
+			return profile.id, profile.config, nil
+		}
+		return uint32(id), nil, fmt.Errorf("no genesis config registered for network ID %d", id)
+	}
+
+	return 0, nil, fmt.Errorf("no genesis config registered for network %q", nameOrID)
+}
+
+// configForNetworkID returns the Config registered for networkID, or nil
+// if none is registered (the caller is then expected to require a custom
+// config file).
+func configForNetworkID(networkID uint32) *Config {
+	networkRegistryMu.RLock()
+	defer networkRegistryMu.RUnlock()
+
+	if profile, ok := networksByID[networkID]; ok {
+		return profile.config
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/utils/hashing"
+)
+
+func TestRegisterNetwork(t *testing.T) {
+	tests := map[string]struct {
+		name string
+		id   uint32
+	}{
+		"mainnet": {name: "mainnet-dup-test", id: 1000001},
+		"fuji":    {name: "fuji-dup-test", id: 1000002},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := LocalConfig
+			RegisterNetwork(test.name, test.id, &cfg)
+
+			gotID, gotCfg, err := LookupNetwork(test.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gotID != test.id {
+				t.Fatalf("expected ID %d but got %d", test.id, gotID)
+			}
+			if gotCfg != &cfg {
+				t.Fatalf("expected to get back the exact registered config")
+			}
+
+			gotID, _, err = LookupNetwork(fmt.Sprintf("%d", test.id))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gotID != test.id {
+				t.Fatalf("expected ID %d but got %d", test.id, gotID)
+			}
+		})
+	}
+}
+
+func TestRegisterNetworkDuplicate(t *testing.T) {
+	t.Run("duplicate id", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected RegisterNetwork to panic on duplicate ID")
+			}
+		}()
+		cfg := LocalConfig
+		RegisterNetwork("dup-id-a", 2000001, &cfg)
+		RegisterNetwork("dup-id-b", 2000001, &cfg)
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected RegisterNetwork to panic on duplicate name")
+			}
+		}()
+		cfg := LocalConfig
+		RegisterNetwork("dup-name", 2000002, &cfg)
+		RegisterNetwork("dup-name", 2000003, &cfg)
+	})
+}
+
+func TestLookupNetworkBuiltins(t *testing.T) {
+	tests := map[string]struct {
+		nameOrID string
+		expected string
+	}{
+		"mainnet by name": {
+			nameOrID: "mainnet",
+			expected: "3e6662fdbd88bcf4c7dd82cb4699c0807f1d7315d493bc38532697e11b226276",
+		},
+		"fuji by name": {
+			nameOrID: "fuji",
+			expected: "2e6b699298a664793bff42dae9c1af8d9c54645d8b376fd331e0b67475578e0a",
+		},
+		"local by name": {
+			nameOrID: "local",
+			expected: "d036edc78cee38f003c529fa2ca3f95da47c7b87f5f3c0e126c9bf34e7f2285a",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			id, cfg, err := LookupNetwork(test.nameOrID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			genesisBytes, _, err := FromConfig(cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			genesisHash := fmt.Sprintf("%x", hashing.ComputeHash256(genesisBytes))
+			if genesisHash != test.expected {
+				t.Fatalf(`expected genesis hash "%s" but got "%s"`, test.expected, genesisHash)
+			}
+			_ = id
+		})
+	}
+}
+
+func TestLookupNetworkUnknown(t *testing.T) {
+	if _, _, err := LookupNetwork("does-not-exist"); err == nil {
+		t.Fatal("expected an error for an unregistered network name")
+	}
+	if _, _, err := LookupNetwork("424242"); err == nil {
+		t.Fatal("expected an error for an unregistered network ID")
+	}
+}
@@ -0,0 +1,54 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/ava-labs/avalanchego/utils/hashing"
+)
+
+// genesisSignatureExtension is appended to a custom genesis config's path
+// to find its detached signature, e.g. config.json -> config.json.sig.
+const genesisSignatureExtension = ".sig"
+
+// TrustedKeys holds the Ed25519 public keys a custom genesis config's
+// detached signature is verified against. It is empty by default and is
+// populated by code that assigns to it, e.g. a linked-in package that
+// adds keys via init(). This package defines no command-line flag for it.
+var TrustedKeys []ed25519.PublicKey
+
+// RequireSignedGenesis, when true, causes a custom genesis config with no
+// companion .sig file to be rejected instead of accepted on a
+// trust-on-first-use basis. It is false by default and is set by code that
+// assigns to it; this package defines no command-line flag for it.
+var RequireSignedGenesis bool
+
+// verifyGenesisSignature checks filepath+".sig", if present, against
+// rawConfig and TrustedKeys. A missing signature file is only an error
+// when RequireSignedGenesis is set; otherwise loading falls back to the
+// historical trust-on-first-use behavior.
+func verifyGenesisSignature(filepath string, rawConfig []byte) error {
+	sig, err := ioutil.ReadFile(filepath + genesisSignatureExtension)
+	if err != nil {
+		if os.IsNotExist(err) {
+			if RequireSignedGenesis {
+				return fmt.Errorf("genesis config at %s has no signature file and --require-signed-genesis is set", filepath)
+			}
+			return nil
+		}
+		return fmt.Errorf("unable to read genesis signature file: %w", err)
+	}
+
+	hash := hashing.ComputeHash256(rawConfig)
+	for _, key := range TrustedKeys {
+		if ed25519.Verify(key, hash, sig) {
+			return nil
+		}
+	}
+	return fmt.Errorf("genesis config at %s has a signature that does not verify against any trusted key", filepath)
+}
@@ -0,0 +1,100 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/utils/hashing"
+)
+
+func TestGenesisSignedConfig(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sign := func(t *testing.T, body []byte) []byte {
+		return ed25519.Sign(priv, hashing.ComputeHash256(body))
+	}
+
+	tests := map[string]struct {
+		trustedKeys          []ed25519.PublicKey
+		requireSignedGenesis bool
+		tamperAfterSigning   bool
+		writeSig             bool
+		err                  string
+	}{
+		"valid signature": {
+			trustedKeys: []ed25519.PublicKey{pub},
+			writeSig:    true,
+		},
+		"wrong key": {
+			trustedKeys: []ed25519.PublicKey{otherPub},
+			writeSig:    true,
+			err:         "does not verify against any trusted key",
+		},
+		"tampered body": {
+			trustedKeys:        []ed25519.PublicKey{pub},
+			writeSig:           true,
+			tamperAfterSigning: true,
+			err:                "does not verify against any trusted key",
+		},
+		"missing sig with required flag": {
+			trustedKeys:          []ed25519.PublicKey{pub},
+			requireSignedGenesis: true,
+			writeSig:             false,
+			err:                  "no signature file and --require-signed-genesis is set",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			oldKeys, oldRequire := TrustedKeys, RequireSignedGenesis
+			defer func() {
+				TrustedKeys, RequireSignedGenesis = oldKeys, oldRequire
+			}()
+			TrustedKeys = test.trustedKeys
+			RequireSignedGenesis = test.requireSignedGenesis
+
+			body := []byte(customGenesisConfigJSON)
+			configPath := path.Join(t.TempDir(), "config.json")
+			if err := ioutil.WriteFile(configPath, body, 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			if test.writeSig {
+				sig := sign(t, body)
+				if test.tamperAfterSigning {
+					if err := ioutil.WriteFile(configPath, append(body, '\n'), 0600); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if err := ioutil.WriteFile(configPath+".sig", sig, 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, _, err := Genesis(9999, configPath)
+			if len(test.err) > 0 {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf(`expected error containing "%s" but got "%v"`, test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import "fmt"
+
+// Vesting types a LockedAmount entry may declare. The zero value ("") is
+// treated the same as VestingCliff, so existing cliff-only configs are
+// unaffected.
+const (
+	VestingCliff   = "cliff"
+	VestingLinear  = "linear"
+	VestingStepped = "stepped"
+)
+
+// expandVestingSchedule expands a "linear" or "stepped" LockedAmount
+// entry into the equivalent set of plain {amount, locktime} cliff drops,
+// one per PeriodSeconds-sized step over Duration. Nothing unlocks before
+// Locktime+Cliff: the amounts of any steps that would otherwise fall
+// before the cliff are rolled forward into the first step at or after the
+// cliff and released at that step's locktime. No step unlocks after
+// Locktime+Duration: with "linear", the extra final step is shorter and
+// unlocks at exactly Locktime+Duration.
+//
+// A "cliff" (or unset) entry is returned unchanged, wrapped in a
+// single-element slice.
+func expandVestingSchedule(entry LockedAmount) ([]LockedAmount, error) {
+	switch entry.VestingType {
+	case "", VestingCliff:
+		return []LockedAmount{{Amount: entry.Amount, Locktime: entry.Locktime}}, nil
+
+	case VestingLinear, VestingStepped:
+		if entry.Duration == 0 {
+			return nil, fmt.Errorf("duration must be > 0 for a %s vest", entry.VestingType)
+		}
+		if entry.PeriodSeconds == 0 {
+			return nil, fmt.Errorf("periodSeconds must be > 0 for a %s vest", entry.VestingType)
+		}
+		if entry.Cliff > entry.Duration {
+			return nil, fmt.Errorf("cliff %d is after the end of the %d second vesting duration", entry.Cliff, entry.Duration)
+		}
+		if entry.VestingType == VestingStepped && entry.Duration%entry.PeriodSeconds != 0 {
+			return nil, fmt.Errorf("periodSeconds %d does not evenly divide duration %d", entry.PeriodSeconds, entry.Duration)
+		}
+
+		numSteps := entry.Duration / entry.PeriodSeconds
+		if entry.Duration%entry.PeriodSeconds != 0 {
+			numSteps++ // linear: absorb the remainder into one extra short final step
+		}
+
+		perStep := entry.Amount / numSteps
+		remainder := entry.Amount % numSteps
+		cliffEnd := entry.Locktime + entry.Cliff
+
+		schedule := make([]LockedAmount, 0, numSteps)
+		var carried uint64
+		for i := uint64(0); i < numSteps; i++ {
+			amount := perStep
+			if i == numSteps-1 {
+				amount += remainder
+			}
+
+			locktime := entry.Locktime + entry.PeriodSeconds*(i+1)
+			if end := entry.Locktime + entry.Duration; locktime > end {
+				locktime = end
+			}
+			if locktime < cliffEnd {
+				carried += amount
+				continue
+			}
+			amount += carried
+			carried = 0
+			schedule = append(schedule, LockedAmount{Amount: amount, Locktime: locktime})
+		}
+		if carried > 0 {
+			schedule = append(schedule, LockedAmount{Amount: carried, Locktime: cliffEnd})
+		}
+		return schedule, nil
+
+	default:
+		return nil, fmt.Errorf("unknown vestingType %q", entry.VestingType)
+	}
+}
+
+// expandAllocationVesting returns a copy of allocations with every
+// linear/stepped UnlockSchedule entry expanded into plain cliff drops, so
+// that the VM genesis builders only ever need to deal with the simple
+// {amount, locktime} form.
+func expandAllocationVesting(allocations []Allocation) ([]Allocation, error) {
+	expanded := make([]Allocation, len(allocations))
+	for i, allocation := range allocations {
+		newSchedule := make([]LockedAmount, 0, len(allocation.UnlockSchedule))
+		for _, unlock := range allocation.UnlockSchedule {
+			steps, err := expandVestingSchedule(unlock)
+			if err != nil {
+				return nil, fmt.Errorf("allocation %s: %w", allocation.AVAXAddr, err)
+			}
+			newSchedule = append(newSchedule, steps...)
+		}
+		expanded[i] = allocation
+		expanded[i].UnlockSchedule = newSchedule
+	}
+	return expanded, nil
+}
@@ -0,0 +1,105 @@
+// (c) 2019-2020, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package genesis
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExpandVestingSchedule(t *testing.T) {
+	tests := map[string]struct {
+		entry LockedAmount
+		err   string
+		total uint64
+		steps int
+	}{
+		"cliff unchanged": {
+			entry: LockedAmount{Amount: 100, Locktime: 1000},
+			total: 100,
+			steps: 1,
+		},
+		"linear with remainder": {
+			entry: LockedAmount{
+				Amount:        100,
+				Locktime:      1000,
+				VestingType:   VestingLinear,
+				Duration:      1000,
+				PeriodSeconds: 300,
+			},
+			total: 100,
+			steps: 4, // 3 full periods + 1 short remainder period
+		},
+		"stepped exact": {
+			entry: LockedAmount{
+				Amount:        120,
+				Locktime:      1000,
+				VestingType:   VestingStepped,
+				Duration:      1200,
+				PeriodSeconds: 300,
+			},
+			total: 120,
+			steps: 4,
+		},
+		"stepped must evenly divide": {
+			entry: LockedAmount{
+				Amount:        100,
+				Locktime:      1000,
+				VestingType:   VestingStepped,
+				Duration:      1000,
+				PeriodSeconds: 300,
+			},
+			err: "does not evenly divide",
+		},
+		"cliff after duration": {
+			entry: LockedAmount{
+				Amount:        100,
+				Locktime:      1000,
+				VestingType:   VestingLinear,
+				Cliff:         2000,
+				Duration:      1000,
+				PeriodSeconds: 300,
+			},
+			err: "is after the end",
+		},
+		"cliff rolls up skipped steps": {
+			entry: LockedAmount{
+				Amount:        100,
+				Locktime:      1000,
+				VestingType:   VestingStepped,
+				Cliff:         700,
+				Duration:      1000,
+				PeriodSeconds: 100,
+			},
+			total: 100,
+			steps: 4, // steps at +100..+600 all roll into the +700 cliff drop, then +800,+900,+1000
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			schedule, err := expandVestingSchedule(test.entry)
+			if len(test.err) > 0 {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf(`expected error containing "%s" but got "%v"`, test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var total uint64
+			for _, step := range schedule {
+				total += step.Amount
+			}
+			if total != test.total {
+				t.Fatalf("expected expanded total %d but got %d", test.total, total)
+			}
+			if len(schedule) != test.steps {
+				t.Fatalf("expected %d steps but got %d: %+v", test.steps, len(schedule), schedule)
+			}
+		})
+	}
+}